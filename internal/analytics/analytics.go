@@ -0,0 +1,41 @@
+// Package analytics owns the Meqenet platform's connection to its product
+// analytics sink. It is not wired to a real sink yet; Module exists so its
+// startup/shutdown ordering is driven by fx like every other subsystem
+// instead of a TODO in app.startServices.
+package analytics
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Client is a placeholder for the analytics sink connection. New will
+// return a real client once a sink is chosen; until then it carries no
+// connection.
+type Client struct{}
+
+// New returns a Client. It does not connect to a sink yet — see Client.
+func New() (*Client, error) {
+	return &Client{}, nil
+}
+
+// registerLifecycle ties Client's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *Client, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("analytics: no sink wired yet, skipping connect")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the analytics package's constructors to the fx application graph.
+var Module = fx.Module("analytics",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
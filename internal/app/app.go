@@ -3,87 +3,80 @@ package app
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"os/signal"
-	"syscall"
 
 	"github.com/meqenet/meqenet/internal/config"
+	"github.com/meqenet/meqenet/internal/i18n"
+	"github.com/meqenet/meqenet/internal/ledger"
+	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
 // App represents the main application
 type App struct {
-	config *config.Config
-	logger *zap.Logger
+	registry   *config.Registry
+	backend    ledger.LedgerBackend
+	translator *i18n.Translator
+	logger     *zap.Logger
 }
 
-// New creates a new application instance
-func New(cfg *config.Config, logger *zap.Logger) (*App, error) {
-	return &App{
-		config: cfg,
-		logger: logger,
-	}, nil
-}
-
-// Start starts the application
-func (a *App) Start() error {
-	a.logger.Info("Meqenet BNPL Platform starting",
-		zap.String("version", "1.0.0"),
-		zap.String("environment", a.config.Environment),
-	)
-
-	// Set up graceful shutdown
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	// Handle shutdown signals
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-
-	// Start core services
-	if err := a.startServices(ctx); err != nil {
-		return fmt.Errorf("failed to start services: %w", err)
+// New creates a new application instance and registers its startup and
+// shutdown sequence with the fx lifecycle. Subsystems (database, cache,
+// queue, httpserver, payments, fraud, analytics) hook into the same
+// lifecycle via their own modules (see each package's Module), so fx starts
+// them in dependency order and stops them in reverse — App itself only
+// needs to log its own start/stop and watch for config changes.
+func New(lc fx.Lifecycle, registry *config.Registry, backend ledger.LedgerBackend, translator *i18n.Translator, logger *zap.Logger) *App {
+	a := &App{
+		registry:   registry,
+		backend:    backend,
+		translator: translator,
+		logger:     logger,
 	}
 
-	a.logger.Info("Meqenet BNPL Platform started successfully")
-
-	// Wait for shutdown signal
-	<-sigChan
-	a.logger.Info("Shutdown signal received, stopping services...")
+	lc.Append(fx.Hook{
+		OnStart: a.startServices,
+		OnStop:  a.stopServices,
+	})
 
-	// Graceful shutdown
-	if err := a.stopServices(ctx); err != nil {
-		a.logger.Error("Error during shutdown", zap.Error(err))
-		return err
-	}
-
-	a.logger.Info("Meqenet BNPL Platform stopped gracefully")
-	return nil
+	return a
 }
 
 // startServices starts all core services
 func (a *App) startServices(ctx context.Context) error {
-	a.logger.Info("Starting core services...")
+	a.logger.Info(a.translator.T(ctx, "app.starting", nil),
+		zap.String("version", "1.0.0"),
+		zap.String("environment", config.Environment.GetString()),
+	)
 
-	// TODO: Initialize database connections
-	// TODO: Initialize Redis cache
-	// TODO: Initialize message queue
-	// TODO: Initialize HTTP server
-	// TODO: Initialize payment processors
-	// TODO: Initialize fraud detection
-	// TODO: Initialize analytics
+	go a.watchConfigChanges()
 
-	a.logger.Info("Core services started successfully")
+	a.logger.Info("Meqenet BNPL Platform started successfully")
 	return nil
 }
 
 // stopServices stops all core services
 func (a *App) stopServices(ctx context.Context) error {
-	a.logger.Info("Stopping core services...")
-
-	// TODO: Gracefully shutdown services in reverse order
+	a.logger.Info("Shutdown signal received, stopping services...")
 
-	a.logger.Info("Core services stopped successfully")
+	a.logger.Info("Meqenet BNPL Platform stopped gracefully")
 	return nil
 }
+
+// watchConfigChanges logs every hot-reloaded config key so operators can see
+// what changed without restarting the process.
+func (a *App) watchConfigChanges() {
+	for change := range a.registry.Subscribe() {
+		a.logger.Info("config key changed",
+			zap.String("key", change.Key.Name),
+			zap.Any("value", change.Value),
+		)
+	}
+}
+
+// Module provides the app package's constructors to the fx application graph
+// and forces App to be instantiated even though nothing depends on it
+// directly.
+var Module = fx.Module("app",
+	fx.Provide(New),
+	fx.Invoke(func(*App) {}),
+)
@@ -0,0 +1,45 @@
+// Package cache owns the Meqenet platform's Redis connection, used for
+// session storage and request-rate bookkeeping. It is not wired to a real
+// client yet; Module exists so startup/shutdown ordering is driven by fx
+// like every other subsystem instead of a TODO in app.startServices.
+package cache
+
+import (
+	"context"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Client is a placeholder for the Redis connection. New will return a real
+// client once one is chosen; until then it carries no connection.
+type Client struct{}
+
+// New returns a Client. It does not read the Redis config or connect yet —
+// see Client.
+func New() (*Client, error) {
+	return &Client{}, nil
+}
+
+// registerLifecycle ties Client's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *Client, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("cache: no redis client wired yet, skipping connect",
+				zap.String("host", config.RedisHost.GetString()),
+				zap.Int("db", config.RedisDB.GetInt()),
+			)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the cache package's constructors to the fx application graph.
+var Module = fx.Module("cache",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var configValidateFile string
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect and validate Meqenet configuration",
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate a config file and exit non-zero on failure",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if configValidateFile != "" {
+			viper.SetConfigFile(configValidateFile)
+		}
+
+		if _, err := config.Load(); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+
+		fmt.Println("configuration is valid")
+		return nil
+	},
+}
+
+func init() {
+	configValidateCmd.Flags().StringVar(&configValidateFile, "file", "", "path to the config file to validate (defaults to the usual config.yaml discovery)")
+	configCmd.AddCommand(configValidateCmd)
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"github.com/spf13/viper"
+)
+
+func TestConfigValidate_HonorsExplicitFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ci-config.yaml")
+	contents := "DB_HOST: ci-postgres\nJWT_SECRET: test-jwt-secret\nENCRYPTION_KEY: test-encryption-key\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+	defer viper.Reset()
+
+	viper.SetConfigFile(path)
+	if _, err := config.Load(); err != nil {
+		t.Fatalf("config.Load() with an explicit --file: %v", err)
+	}
+
+	if got := config.DatabaseHost.GetString(); got != "ci-postgres" {
+		t.Errorf("DatabaseHost.GetString() = %q, want the value from %s, not the default/discovered config.yaml", got, path)
+	}
+}
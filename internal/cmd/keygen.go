@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var keygenOutputPath string
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen",
+	Short: "Rotate JWT_SECRET and ENCRYPTION_KEY and write them to an env file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		jwtSecret, err := randomSecret(32)
+		if err != nil {
+			return fmt.Errorf("generating JWT_SECRET: %w", err)
+		}
+
+		encryptionKey, err := randomSecret(32)
+		if err != nil {
+			return fmt.Errorf("generating ENCRYPTION_KEY: %w", err)
+		}
+
+		if err := upsertEnvFile(keygenOutputPath, jwtSecret, encryptionKey); err != nil {
+			return fmt.Errorf("writing %s: %w", keygenOutputPath, err)
+		}
+
+		fmt.Printf("Rotated JWT_SECRET and ENCRYPTION_KEY into %s\n", keygenOutputPath)
+		return nil
+	},
+}
+
+func init() {
+	// TODO: support writing to Vault instead of a local env file once the
+	// platform has a Vault endpoint to rotate against.
+	keygenCmd.Flags().StringVar(&keygenOutputPath, "output", ".env", "env file to write the rotated secrets to")
+}
+
+// randomSecret returns a URL-safe base64 string encoding n random bytes.
+func randomSecret(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// upsertEnvFile rewrites JWT_SECRET and ENCRYPTION_KEY in the env file at
+// path, leaving every other line (DB_HOST, REDIS_*, ...) untouched. A
+// missing file is treated as empty rather than an error, since `keygen` is
+// often the first command run against a fresh environment.
+func upsertEnvFile(path, jwtSecret, encryptionKey string) error {
+	lines, err := readEnvLines(path)
+	if err != nil {
+		return err
+	}
+
+	lines = upsertEnvLine(lines, "JWT_SECRET", jwtSecret)
+	lines = upsertEnvLine(lines, "ENCRYPTION_KEY", encryptionKey)
+
+	contents := strings.Join(lines, "\n") + "\n"
+	return os.WriteFile(path, []byte(contents), 0o600)
+}
+
+func readEnvLines(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	text := strings.TrimRight(string(data), "\n")
+	if text == "" {
+		return nil, nil
+	}
+	return strings.Split(text, "\n"), nil
+}
+
+// upsertEnvLine replaces the existing KEY=value line for key, or appends one
+// if key isn't already present.
+func upsertEnvLine(lines []string, key, value string) []string {
+	prefix := key + "="
+	for i, line := range lines {
+		if strings.HasPrefix(line, prefix) {
+			lines[i] = prefix + value
+			return lines
+		}
+	}
+	return append(lines, prefix+value)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpsertEnvFile_PreservesUnrelatedSettings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	original := "DB_HOST=db.internal\nJWT_SECRET=old-secret\nREDIS_PASSWORD=hunter2\n"
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+
+	if err := upsertEnvFile(path, "new-jwt-secret", "new-encryption-key"); err != nil {
+		t.Fatalf("upsertEnvFile: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading result: %v", err)
+	}
+	contents := string(got)
+
+	for _, want := range []string{"DB_HOST=db.internal", "REDIS_PASSWORD=hunter2", "JWT_SECRET=new-jwt-secret", "ENCRYPTION_KEY=new-encryption-key"} {
+		if !strings.Contains(contents, want) {
+			t.Errorf("result %q missing %q", contents, want)
+		}
+	}
+	if strings.Contains(contents, "old-secret") {
+		t.Errorf("result %q still contains the stale JWT_SECRET value", contents)
+	}
+}
+
+func TestUpsertEnvFile_MissingFileIsTreatedAsEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+
+	if err := upsertEnvFile(path, "jwt-secret", "encryption-key"); err != nil {
+		t.Fatalf("upsertEnvFile: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading result: %v", err)
+	}
+	contents := string(got)
+	if !strings.Contains(contents, "JWT_SECRET=jwt-secret") || !strings.Contains(contents, "ENCRYPTION_KEY=encryption-key") {
+		t.Errorf("result %q missing expected keys", contents)
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"github.com/meqenet/meqenet/internal/logging"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// errMigrationDriverNotImplemented is returned by every `migrate` subcommand
+// until a real driver is wired up, so CI and operators get a loud failure
+// instead of a silent no-op success.
+var errMigrationDriverNotImplemented = errors.New("migrate: no migration driver wired up yet")
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage database schema migrations",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	RunE:  runMigrate("up"),
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Roll back the most recently applied migration",
+	RunE:  runMigrate("down"),
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which migrations have been applied",
+	RunE:  runMigrate("status"),
+}
+
+func init() {
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+}
+
+// runMigrate bootstraps config and logging the same way `serve` does, then
+// logs the requested migration direction and returns
+// errMigrationDriverNotImplemented; it applies no migration.
+func runMigrate(direction string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if _, err := config.Load(); err != nil {
+			return fmt.Errorf("loading config: %w", err)
+		}
+
+		logger := logging.NewLogger()
+		defer logger.Sync()
+
+		logger.Info("refusing to run database migration: no driver wired up yet",
+			zap.String("direction", direction),
+			zap.String("host", config.DatabaseHost.GetString()),
+			zap.String("database", config.DatabaseName.GetString()),
+		)
+
+		// TODO: wire a real migration driver (e.g. golang-migrate) against
+		// config.DatabaseHost/config.DatabaseName and run `direction` here.
+
+		return errMigrationDriverNotImplemented
+	}
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestRunMigrate_FailsLoudlyWithNoDriverWired(t *testing.T) {
+	defer viper.Reset()
+	viper.Set("JWT_SECRET", "test-jwt-secret")
+	viper.Set("ENCRYPTION_KEY", "test-encryption-key")
+
+	err := runMigrate("up")(migrateUpCmd, nil)
+	if !errors.Is(err, errMigrationDriverNotImplemented) {
+		t.Errorf("runMigrate(\"up\")(...) = %v, want errMigrationDriverNotImplemented", err)
+	}
+}
@@ -0,0 +1,22 @@
+// Package cmd wires the meqenet binary's subcommands. `serve` and `migrate`
+// share the same config.Load + logging.NewLogger bootstrap; `config
+// validate` runs config.Load only, and `keygen` and `verify-attestation`
+// run neither.
+package cmd
+
+import "github.com/spf13/cobra"
+
+var rootCmd = &cobra.Command{
+	Use:   "meqenet",
+	Short: "Meqenet BNPL platform",
+	Long:  "Meqenet is Ethiopia's Buy Now Pay Later (BNPL) platform.",
+}
+
+// Execute runs the root command, dispatching to the requested subcommand.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd, migrateCmd, keygenCmd, configCmd, verifyAttestationCmd)
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/meqenet/meqenet/internal/analytics"
+	"github.com/meqenet/meqenet/internal/app"
+	"github.com/meqenet/meqenet/internal/cache"
+	"github.com/meqenet/meqenet/internal/config"
+	"github.com/meqenet/meqenet/internal/database"
+	"github.com/meqenet/meqenet/internal/fraud"
+	"github.com/meqenet/meqenet/internal/httpserver"
+	"github.com/meqenet/meqenet/internal/i18n"
+	"github.com/meqenet/meqenet/internal/ledger"
+	"github.com/meqenet/meqenet/internal/logging"
+	"github.com/meqenet/meqenet/internal/payments"
+	"github.com/meqenet/meqenet/internal/queue"
+	"github.com/spf13/cobra"
+	"go.uber.org/fx"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run the Meqenet BNPL platform",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fx.New(
+			config.Module,
+			logging.Module,
+			database.Module,
+			cache.Module,
+			queue.Module,
+			ledger.Module,
+			i18n.Module,
+			httpserver.Module,
+			payments.Module,
+			fraud.Module,
+			analytics.Module,
+			app.Module,
+		).Run()
+		return nil
+	},
+}
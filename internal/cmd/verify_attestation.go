@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+	"github.com/sigstore/cosign/v2/cmd/cosign/cli/options"
+	"github.com/sigstore/cosign/v2/cmd/cosign/cli/verify"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifyAttestationKeyRef        string
+	verifyAttestationSignaturePath string
+	verifyAttestationBundlePath    string
+)
+
+var verifyAttestationCmd = &cobra.Command{
+	Use:   "verify-attestation <artifact>",
+	Short: "Verify a built binary's SLSA provenance attestation before it runs in production",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if verifyAttestationSignaturePath == "" && verifyAttestationBundlePath == "" {
+			return fmt.Errorf("one of --signature or --bundle is required to locate the DSSE envelope")
+		}
+
+		artifact := args[0]
+
+		verifier := verify.VerifyBlobAttestationCommand{
+			KeyOpts: options.KeyOpts{
+				KeyRef:     verifyAttestationKeyRef,
+				BundlePath: verifyAttestationBundlePath,
+			},
+			SignaturePath: verifyAttestationSignaturePath,
+			CheckClaims:   true,
+			PredicateType: intoto.PredicateSLSAProvenance,
+		}
+
+		if err := verifier.Exec(cmd.Context(), artifact); err != nil {
+			return fmt.Errorf("attestation verification failed for %s: %w", artifact, err)
+		}
+
+		fmt.Printf("%s: SLSA provenance verified\n", artifact)
+		return nil
+	},
+}
+
+func init() {
+	verifyAttestationCmd.Flags().StringVar(&verifyAttestationKeyRef, "key", "", "public key or KMS URI to verify the attestation signature against")
+	verifyAttestationCmd.Flags().StringVar(&verifyAttestationSignaturePath, "signature", "", "path to the detached DSSE envelope signature")
+	verifyAttestationCmd.Flags().StringVar(&verifyAttestationBundlePath, "bundle", "", "path to the Sigstore bundle containing the signature and verification material")
+}
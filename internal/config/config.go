@@ -0,0 +1,228 @@
+// Package config provides type-safe, hot-reloadable configuration. Every
+// value the application reads is declared once as a Key (see keys below)
+// and resolved through its accessor, e.g. config.ServicePort.GetInt(). Run
+// `go doc config` to see every key the binary understands.
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+)
+
+// subscriberBufferSize bounds how many undelivered ChangeEvents a Subscribe
+// caller can fall behind by before further events are dropped for it.
+const subscriberBufferSize = 16
+
+// Application keys.
+var (
+	Environment = NewKey("ENVIRONMENT", WithDefault("development"))
+	ServicePort = NewKey("PORT", WithDefault(8080))
+)
+
+// Database keys.
+var (
+	DatabaseHost     = NewKey("DB_HOST", WithDefault("localhost"))
+	DatabasePort     = NewKey("DB_PORT", WithDefault(5432))
+	DatabaseUser     = NewKey("DB_USER", WithDefault("meqenet"))
+	DatabasePassword = NewKey("DB_PASSWORD")
+	DatabaseName     = NewKey("DB_NAME", WithDefault("meqenet"))
+	DatabaseSSLMode  = NewKey("DB_SSL_MODE", WithDefault("require"))
+)
+
+// Redis keys.
+var (
+	RedisHost     = NewKey("REDIS_HOST", WithDefault("localhost"))
+	RedisPort     = NewKey("REDIS_PORT", WithDefault(6379))
+	RedisPassword = NewKey("REDIS_PASSWORD")
+	RedisDB       = NewKey("REDIS_DB", WithDefault(0))
+)
+
+// Security keys.
+var (
+	JWTSecret     = NewKey("JWT_SECRET", WithValidator(Required))
+	JWTExpiry     = NewKey("JWT_EXPIRY", WithDefault("24h"))
+	EncryptionKey = NewKey("ENCRYPTION_KEY", WithValidator(Required))
+)
+
+// Ethiopian localization keys.
+var (
+	DefaultLanguage = NewKey("DEFAULT_LANGUAGE", WithDefault("en"))
+	TimeZone        = NewTimeZoneKey("TIMEZONE", WithDefault("Africa/Addis_Ababa"))
+)
+
+// TimeZoneKey is a Key whose value is an IANA time zone name, with a
+// GetLocation accessor on top of the usual string accessors.
+type TimeZoneKey struct {
+	Key
+}
+
+// NewTimeZoneKey builds and registers a TimeZoneKey.
+func NewTimeZoneKey(name string, opts ...KeyOption) *TimeZoneKey {
+	tzk := &TimeZoneKey{}
+	for _, opt := range opts {
+		opt(&tzk.Key)
+	}
+	tzk.Key.Name = name
+	RegisterKey(&tzk.Key)
+	return tzk
+}
+
+// GetLocation parses the current value as an IANA time zone name.
+func (k *TimeZoneKey) GetLocation() (*time.Location, error) {
+	name := k.GetString()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// ChangeEvent is published to a Registry's subscribers whenever a key's
+// resolved value actually changes and the reload revalidates successfully.
+type ChangeEvent struct {
+	Key   *Key
+	Value interface{}
+}
+
+// Registry is the result of Load: it keeps the config file being watched
+// for the lifetime of the process and fans out ChangeEvents to subscribers
+// (logger level, DB pool sizes, feature flags, ...) so they can react
+// without a restart. Each subscriber gets its own channel, so one consumer
+// falling behind or a second one subscribing doesn't steal events meant for
+// another.
+type Registry struct {
+	mu          sync.Mutex
+	subscribers []chan ChangeEvent
+	lastValues  map[string]interface{}
+}
+
+// Subscribe returns a channel that receives the ChangeEvents published from
+// this point on, minus any dropped while its buffer was full (see publish).
+// The channel is never closed; it lives for the process's lifetime.
+func (r *Registry) Subscribe() <-chan ChangeEvent {
+	ch := make(chan ChangeEvent, subscriberBufferSize)
+
+	r.mu.Lock()
+	r.subscribers = append(r.subscribers, ch)
+	r.mu.Unlock()
+
+	return ch
+}
+
+// publish delivers event to every current subscriber. Delivery is
+// best-effort: a subscriber too slow to keep up with its own buffer misses
+// the event rather than blocking the reload that produced it.
+func (r *Registry) publish(event ChangeEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, ch := range r.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// changed reports whether value differs from name's last-known value,
+// recording value as the new last-known value either way so the next reload
+// diffs against this one.
+func (r *Registry) changed(name string, value interface{}) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if reflect.DeepEqual(r.lastValues[name], value) {
+		return false
+	}
+	r.lastValues[name] = value
+	return true
+}
+
+// Load resolves every registered key's default, reads the optional YAML
+// config file and environment variables, validates the result, and then
+// watches the config file for changes, revalidating and republishing on
+// every edit.
+func Load() (*Registry, error) {
+	// viper.SetConfigName wipes out any config file set via SetConfigFile
+	// (see viper's own Viper.SetConfigName), so skip the usual name/path
+	// discovery entirely when a caller (e.g. `meqenet config validate
+	// --file`) has already pinned an explicit file.
+	if viper.ConfigFileUsed() == "" {
+		viper.SetConfigName("config")
+		viper.SetConfigType("yaml")
+		viper.AddConfigPath(".")
+		viper.AddConfigPath("./config")
+	}
+	viper.AutomaticEnv()
+
+	keys := registeredKeys()
+	for _, k := range keys {
+		if k.Default != nil {
+			viper.SetDefault(k.Name, k.Default)
+		}
+	}
+
+	if err := viper.ReadInConfig(); err != nil {
+		// Config file is optional, so we ignore the error if the file doesn't exist
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, fmt.Errorf("error reading config file: %w", err)
+		}
+	}
+
+	if err := validateAll(keys); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	registryInstance := &Registry{lastValues: snapshotValues(keys)}
+
+	viper.OnConfigChange(func(_ fsnotify.Event) {
+		if err := validateAll(keys); err != nil {
+			// Keep serving the last-known-good values; subscribers never see
+			// an invalid reload.
+			return
+		}
+		for _, k := range keys {
+			value := viper.Get(k.Name)
+			if registryInstance.changed(k.Name, value) {
+				registryInstance.publish(ChangeEvent{Key: k, Value: value})
+			}
+		}
+	})
+	viper.WatchConfig()
+
+	return registryInstance, nil
+}
+
+// snapshotValues captures every key's current value so the first reload has
+// something to diff against.
+func snapshotValues(keys []*Key) map[string]interface{} {
+	values := make(map[string]interface{}, len(keys))
+	for _, k := range keys {
+		values[k.Name] = viper.Get(k.Name)
+	}
+	return values
+}
+
+// validateAll runs every key's validator against its current value.
+func validateAll(keys []*Key) error {
+	for _, k := range keys {
+		if k.Validate == nil {
+			continue
+		}
+		if err := k.Validate(viper.Get(k.Name)); err != nil {
+			return fmt.Errorf("%s: %w", k.Name, err)
+		}
+	}
+	return nil
+}
+
+// Module provides the config package's constructors to the fx application graph.
+var Module = fx.Module("config",
+	fx.Provide(Load),
+)
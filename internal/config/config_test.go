@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegistry_SubscribeFansOutToEachSubscriber(t *testing.T) {
+	r := &Registry{lastValues: map[string]interface{}{}}
+
+	first := r.Subscribe()
+	second := r.Subscribe()
+
+	event := ChangeEvent{Key: &Key{Name: "LOG_COMPRESS"}, Value: false}
+	r.publish(event)
+
+	for name, ch := range map[string]<-chan ChangeEvent{"first": first, "second": second} {
+		select {
+		case got := <-ch:
+			if got.Key.Name != "LOG_COMPRESS" {
+				t.Errorf("%s subscriber got key %q, want LOG_COMPRESS", name, got.Key.Name)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("%s subscriber never received the published event", name)
+		}
+	}
+}
+
+func TestRegistry_ChangedOnlyReportsRealDeltas(t *testing.T) {
+	r := &Registry{lastValues: map[string]interface{}{"DB_HOST": "localhost"}}
+
+	if r.changed("DB_HOST", "localhost") {
+		t.Error("changed() = true for an unchanged value, want false")
+	}
+	if !r.changed("DB_HOST", "db.internal") {
+		t.Error("changed() = false for a value that actually changed, want true")
+	}
+	if r.changed("DB_HOST", "db.internal") {
+		t.Error("changed() = true on the second call with the same new value, want false")
+	}
+}
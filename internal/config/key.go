@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// Validator checks a resolved config value and returns an error if it is
+// unacceptable. It runs once at Load and again every time the underlying
+// file or environment changes.
+type Validator func(value interface{}) error
+
+// Key describes a single configuration value: where it comes from (env var
+// / YAML path, both driven by viper's key name), what it defaults to, and
+// how to validate it. Accessor methods (GetString, GetInt, ...) read the
+// live value straight out of viper, so a key always reflects the most
+// recent config reload.
+//
+// Keys are declared once as package-level vars (see ServicePort,
+// DatabaseHost, etc. in config.go) and discoverable with `go doc config` instead of
+// scattering stringly-typed viper.GetString("...") calls through the
+// codebase.
+type Key struct {
+	Name     string
+	Default  interface{}
+	Validate Validator
+}
+
+// GetString returns the key's current value as a string.
+func (k *Key) GetString() string {
+	return viper.GetString(k.Name)
+}
+
+// GetInt returns the key's current value as an int.
+func (k *Key) GetInt() int {
+	return viper.GetInt(k.Name)
+}
+
+// GetBool returns the key's current value as a bool.
+func (k *Key) GetBool() bool {
+	return viper.GetBool(k.Name)
+}
+
+// KeyOption configures a Key at registration time.
+type KeyOption func(*Key)
+
+// WithDefault sets the key's default value.
+func WithDefault(value interface{}) KeyOption {
+	return func(k *Key) {
+		k.Default = value
+	}
+}
+
+// WithValidator attaches a validator that runs at Load and on every config
+// reload.
+func WithValidator(validate Validator) KeyOption {
+	return func(k *Key) {
+		k.Validate = validate
+	}
+}
+
+// Required is a Validator that rejects empty strings, useful for secrets
+// like JWT_SECRET and ENCRYPTION_KEY that have no sane default.
+func Required(value interface{}) error {
+	if value == nil || value == "" {
+		return fmt.Errorf("value is required")
+	}
+	return nil
+}
+
+var (
+	registryMu sync.Mutex
+	registry   []*Key
+)
+
+// RegisterKey adds a key to the set that Load resolves defaults and runs
+// validators for. Downstream packages (payment, fraud, ...) call this to
+// declare their own config keys without editing the core config package.
+func RegisterKey(k *Key) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry = append(registry, k)
+}
+
+// NewKey builds a Key, registers it, and returns it for assignment to a
+// package-level var.
+func NewKey(name string, opts ...KeyOption) *Key {
+	k := &Key{Name: name}
+	for _, opt := range opts {
+		opt(k)
+	}
+	RegisterKey(k)
+	return k
+}
+
+// registeredKeys returns a snapshot of every key registered so far.
+func registeredKeys() []*Key {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	return append([]*Key(nil), registry...)
+}
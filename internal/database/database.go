@@ -0,0 +1,46 @@
+// Package database owns the Meqenet platform's connection to its primary
+// Postgres database. It is not wired to a real driver yet; Module exists so
+// startup/shutdown ordering is driven by fx like every other subsystem
+// instead of a TODO in app.startServices.
+package database
+
+import (
+	"context"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// DB is a placeholder for the connection pool. New will return a real
+// *sql.DB (or equivalent) once a driver is chosen; until then it carries no
+// connection.
+type DB struct{}
+
+// New returns a DB. It does not read the database config or open a
+// connection yet — see DB.
+func New() (*DB, error) {
+	return &DB{}, nil
+}
+
+// registerLifecycle ties DB's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *DB, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("database: no driver wired yet, skipping connect",
+				zap.String("host", config.DatabaseHost.GetString()),
+				zap.String("database", config.DatabaseName.GetString()),
+			)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the database package's constructors to the fx application graph.
+var Module = fx.Module("database",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
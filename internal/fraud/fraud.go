@@ -0,0 +1,42 @@
+// Package fraud owns the Meqenet platform's connection to its fraud
+// detection service, consulted before settling installments. It is not
+// wired to a real service yet; Module exists so its startup/shutdown
+// ordering is driven by fx like every other subsystem instead of a TODO in
+// app.startServices.
+package fraud
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Client is a placeholder for the fraud detection service connection. New
+// will return a real client once a service is chosen; until then it carries
+// no connection.
+type Client struct{}
+
+// New returns a Client. It does not connect to a service yet — see Client.
+func New() (*Client, error) {
+	return &Client{}, nil
+}
+
+// registerLifecycle ties Client's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *Client, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("fraud: no detection service wired yet, skipping connect")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the fraud package's constructors to the fx application graph.
+var Module = fx.Module("fraud",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
@@ -0,0 +1,45 @@
+// Package httpserver owns the Meqenet platform's public HTTP listener. It
+// does not serve any routes yet; Module exists so its startup/shutdown
+// ordering is driven by fx like every other subsystem instead of a TODO in
+// app.startServices.
+package httpserver
+
+import (
+	"context"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Server is a placeholder for the HTTP listener. New will return a real
+// *http.Server once routes exist; until then it isn't listening on
+// anything.
+type Server struct{}
+
+// New returns a Server. It does not read the server's port or start
+// listening yet — see Server.
+func New() (*Server, error) {
+	return &Server{}, nil
+}
+
+// registerLifecycle ties Server's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *Server, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("httpserver: no routes wired yet, skipping listen",
+				zap.Int("port", config.ServicePort.GetInt()),
+			)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the httpserver package's constructors to the fx application graph.
+var Module = fx.Module("httpserver",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
@@ -0,0 +1,97 @@
+package i18n
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kljensen/snowball"
+	"golang.org/x/text/unicode/norm"
+)
+
+// geezDigitToASCII converts a Ge'ez ones-digit numeral (e.g. ፩, ፪, ፫) to its
+// ASCII equivalent. Ge'ez numerals are not positional like Arabic numerals
+// (tens, hundreds, and ten-thousands each get their own glyph: ፲, ፻, ፼), so
+// this only folds the ones-digit glyphs; larger figures are left as their
+// Ge'ez spelling.
+func geezDigitToASCII(r rune) (rune, bool) {
+	switch r {
+	case '፩':
+		return '1', true
+	case '፪':
+		return '2', true
+	case '፫':
+		return '3', true
+	case '፬':
+		return '4', true
+	case '፭':
+		return '5', true
+	case '፮':
+		return '6', true
+	case '፯':
+		return '7', true
+	case '፰':
+		return '8', true
+	case '፱':
+		return '9', true
+	default:
+		return 0, false
+	}
+}
+
+// FoldAmharic normalizes text for search indexing of merchant names and
+// product titles: it NFC-normalizes the Ge'ez script runes, converts Ge'ez
+// ones-digit numerals to ASCII, lower-cases, and collapses whitespace and
+// punctuation runs to a single space.
+func FoldAmharic(s string) string {
+	normalized := norm.NFC.String(s)
+
+	var b strings.Builder
+	lastWasSpace := false
+	for _, r := range normalized {
+		if ascii, ok := geezDigitToASCII(r); ok {
+			b.WriteRune(ascii)
+			lastWasSpace = false
+			continue
+		}
+
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			if !lastWasSpace {
+				b.WriteRune(' ')
+				lastWasSpace = true
+			}
+			continue
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+		lastWasSpace = false
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// Stem folds token and reduces it to its stem for search indexing. Snowball
+// has no Amharic algorithm, so Ge'ez-script tokens are folded but left
+// unstemmed; Latin-script tokens (e.g. transliterated or English merchant
+// names) are stemmed with snowball's English algorithm.
+func Stem(token string) string {
+	folded := FoldAmharic(token)
+	if containsGeez(folded) {
+		return folded
+	}
+
+	stemmed, err := snowball.Stem(folded, "english", true)
+	if err != nil {
+		return folded
+	}
+	return stemmed
+}
+
+// containsGeez reports whether s has any rune in the Ethiopic Unicode block.
+func containsGeez(s string) bool {
+	for _, r := range s {
+		if r >= 0x1200 && r <= 0x137F {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"github.com/shopspring/decimal"
+)
+
+// FormatCurrency renders amount as Ethiopian Birr for user-facing
+// statements.
+func FormatCurrency(amount decimal.Decimal) string {
+	return fmt.Sprintf("ETB %s", amount.StringFixed(2))
+}
+
+// FormatStatementDate renders t, in the configured time zone, as an
+// Ethiopian calendar (13-month) date for user-facing statements. The ledger
+// itself always keeps ISO-8601 timestamps (see FormatLedgerDate); this is
+// presentation-only.
+func FormatStatementDate(t time.Time) (string, error) {
+	loc, err := config.TimeZone.GetLocation()
+	if err != nil {
+		return "", err
+	}
+
+	year, month, day := toEthiopian(t.In(loc))
+	return fmt.Sprintf("%04d-%02d-%02d (Ethiopian)", year, month, day), nil
+}
+
+// FormatLedgerDate renders t as the ISO-8601 timestamp the ledger stores,
+// regardless of the caller's locale.
+func FormatLedgerDate(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// toEthiopian converts a Gregorian time to its Ethiopian calendar
+// year/month/day, using g's own location for the new-year boundary so a
+// timestamp near local midnight doesn't flip to the next Ethiopian day/year
+// a few hours early just because UTC already has. The Ethiopian year has 12
+// months of 30 days plus a 13th month (Pagume) of 5 or 6 days; this returns
+// Pagume as month 13.
+func toEthiopian(g time.Time) (year, month, day int) {
+	loc := g.Location()
+	newYear := ethiopianNewYear(g.Year(), loc)
+	year = g.Year() - 7
+
+	if g.Before(newYear) {
+		year--
+		newYear = ethiopianNewYear(g.Year()-1, loc)
+	}
+
+	days := daysBetween(newYear, g)
+	month = days/30 + 1
+	day = days%30 + 1
+	return year, month, day
+}
+
+// ethiopianNewYear returns the Gregorian date of Meskerem 1 (Ethiopian New
+// Year) that falls within Gregorian year g, at local midnight in loc:
+// September 11, or September 12 in the year before a Gregorian leap year.
+func ethiopianNewYear(g int, loc *time.Location) time.Time {
+	day := 11
+	if isGregorianLeap(g + 1) {
+		day = 12
+	}
+	return time.Date(g, time.September, day, 0, 0, 0, 0, loc)
+}
+
+// daysBetween counts whole calendar days between from and to in from's
+// location, ignoring time-of-day so the comparison isn't skewed by exactly
+// how many hours into the day each timestamp falls.
+func daysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, from.Location())
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+func isGregorianLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
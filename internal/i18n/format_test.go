@@ -0,0 +1,50 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToEthiopian_AddisAbabaNewYearBoundary(t *testing.T) {
+	loc, err := time.LoadLocation("Africa/Addis_Ababa")
+	if err != nil {
+		t.Skipf("tzdata not available: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		local     time.Time
+		wantYear  int
+		wantMonth int
+		wantDay   int
+	}{
+		{
+			// Meskerem 1, 2018 (Ethiopian New Year) begins at local
+			// midnight, which is 21:00 UTC on the prior Gregorian day. A
+			// UTC-anchored boundary would still treat this instant as
+			// belonging to the prior Ethiopian year.
+			name:      "01:00 local on the new year is already Meskerem 1, not Pagume",
+			local:     time.Date(2025, time.September, 11, 1, 0, 0, 0, loc),
+			wantYear:  2018,
+			wantMonth: 1,
+			wantDay:   1,
+		},
+		{
+			name:      "23:30 local the day before is still the prior Ethiopian year",
+			local:     time.Date(2025, time.September, 10, 23, 30, 0, 0, loc),
+			wantYear:  2017,
+			wantMonth: 13,
+			wantDay:   5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, month, day := toEthiopian(tt.local)
+			if year != tt.wantYear || month != tt.wantMonth || day != tt.wantDay {
+				t.Errorf("toEthiopian(%v) = %04d-%02d-%02d, want %04d-%02d-%02d",
+					tt.local, year, month, day, tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Package i18n provides Amharic-aware localization: message catalogs, search
+// folding/stemming for Ge'ez script, and Ethiopian-calendar formatting, so
+// individual services (payment reminders, SMS, receipts) don't each
+// reimplement locale handling.
+package i18n
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"go.uber.org/fx"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed locales/*.yaml
+var localeFiles embed.FS
+
+// Translator renders message IDs (e.g. "installment.due") into the caller's
+// language, falling back to a default language and then to the raw message
+// ID if no catalog has a translation.
+type Translator struct {
+	catalogs map[string]map[string]string
+	fallback string
+}
+
+// NewTranslator loads every locales/*.yaml catalog embedded in the binary.
+// fallback names the language used when a request's language has no
+// catalog, or the catalog is missing a key.
+func NewTranslator(fallback string) (*Translator, error) {
+	entries, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		return nil, fmt.Errorf("reading locale catalogs: %w", err)
+	}
+
+	catalogs := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		lang := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+
+		data, err := localeFiles.ReadFile("locales/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("reading locale catalog %s: %w", entry.Name(), err)
+		}
+
+		var messages map[string]string
+		if err := yaml.Unmarshal(data, &messages); err != nil {
+			return nil, fmt.Errorf("parsing locale catalog %s: %w", entry.Name(), err)
+		}
+
+		catalogs[lang] = messages
+	}
+
+	return &Translator{catalogs: catalogs, fallback: fallback}, nil
+}
+
+// NewTranslatorFromConfig builds a Translator using config.DefaultLanguage
+// as the fallback.
+func NewTranslatorFromConfig() (*Translator, error) {
+	return NewTranslator(config.DefaultLanguage.GetString())
+}
+
+type contextKey string
+
+// languageContextKey carries the language resolved from a request's
+// Accept-Language header (see WithLanguage).
+const languageContextKey contextKey = "meqenet-language"
+
+// WithLanguage attaches a resolved language (e.g. from Accept-Language) to
+// ctx so T renders in that language.
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageContextKey, lang)
+}
+
+// T renders message id in the language carried on ctx, falling back to the
+// Translator's default language and then to id itself.
+func (t *Translator) T(ctx context.Context, id string, args map[string]interface{}) string {
+	lang, _ := ctx.Value(languageContextKey).(string)
+
+	message, ok := t.lookup(lang, id)
+	if !ok {
+		message, ok = t.lookup(t.fallback, id)
+	}
+	if !ok {
+		return id
+	}
+
+	return render(message, args)
+}
+
+func (t *Translator) lookup(lang, id string) (string, bool) {
+	catalog, ok := t.catalogs[lang]
+	if !ok {
+		return "", false
+	}
+	message, ok := catalog[id]
+	return message, ok
+}
+
+// render interpolates args into message using Go's text/template syntax
+// (e.g. "{{.Amount}}"), returning the raw message unchanged if it's
+// malformed rather than panicking on user-facing output.
+func render(message string, args map[string]interface{}) string {
+	tmpl, err := template.New("message").Parse(message)
+	if err != nil {
+		return message
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, args); err != nil {
+		return message
+	}
+	return buf.String()
+}
+
+// Module provides the i18n package's constructors to the fx application graph.
+var Module = fx.Module("i18n",
+	fx.Provide(NewTranslatorFromConfig),
+)
@@ -0,0 +1,84 @@
+// Package ledger defines the settlement boundary between loan servicing and
+// the Ethiopian payment rails (Telebirr, CBE Birr, HelloCash, direct bank
+// rails) as well as Meqenet's own in-house ledger for cashback. Callers only
+// ever see the LedgerBackend interface; which rail actually moves money is a
+// config-time decision (see BackendType and NewBackend).
+package ledger
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LoanID identifies the loan an installment settlement belongs to.
+type LoanID string
+
+// TxRef is the settlement rail's reference for a completed transaction,
+// returned so it can be reconciled against statements from that rail.
+type TxRef string
+
+// LedgerEvent is a single entry read back off a backend's event stream, used
+// to reconcile Meqenet's ledger against the rail's.
+type LedgerEvent struct {
+	Cursor    string
+	LoanID    LoanID
+	TxRef     TxRef
+	Amount    decimal.Decimal
+	Timestamp time.Time
+}
+
+// ErrNotImplemented is returned by backends for rails that are not yet wired
+// up to live credentials.
+var ErrNotImplemented = errors.New("ledger: backend not implemented")
+
+// LedgerBackend settles and refunds installment payments against a single
+// settlement rail, and streams back the events that rail has recorded.
+type LedgerBackend interface {
+	// SettleInstallment moves amount for loanID to the rail and returns its
+	// transaction reference.
+	SettleInstallment(ctx context.Context, loanID LoanID, amount decimal.Decimal) (TxRef, error)
+
+	// RefundInstallment reverses a prior settlement for loanID.
+	RefundInstallment(ctx context.Context, loanID LoanID, amount decimal.Decimal) (TxRef, error)
+
+	// GetBalance returns the outstanding balance the rail holds for loanID.
+	GetBalance(ctx context.Context, loanID LoanID) (decimal.Decimal, error)
+
+	// StreamEvents emits every event recorded after fromCursor. An empty
+	// cursor replays from the beginning.
+	StreamEvents(ctx context.Context, fromCursor string) (<-chan LedgerEvent, error)
+
+	// Close releases any resources (connections, open files) held by the
+	// backend.
+	Close() error
+}
+
+// BackendType selects which settlement rail a LedgerBackend talks to.
+type BackendType string
+
+const (
+	// BackendInternal is Meqenet's own in-house ledger, used for cashback
+	// and other balances that never touch an external rail.
+	BackendInternal BackendType = "internal"
+	// BackendTelebirr settles against Ethio Telecom's Telebirr rail.
+	BackendTelebirr BackendType = "telebirr"
+	// BackendCBE settles against the Commercial Bank of Ethiopia's CBE Birr
+	// rail.
+	BackendCBE BackendType = "cbe"
+	// BackendPrecomputed replays a deterministic, file-backed event log
+	// instead of talking to a rail, for integration tests.
+	BackendPrecomputed BackendType = "precomputed"
+)
+
+// ParseBackendType validates that s names a known BackendType.
+func ParseBackendType(s string) (BackendType, error) {
+	switch t := BackendType(s); t {
+	case BackendInternal, BackendTelebirr, BackendCBE, BackendPrecomputed:
+		return t, nil
+	default:
+		return "", errors.New("ledger: unknown backend type " + s)
+	}
+}
@@ -0,0 +1,64 @@
+package ledger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/meqenet/meqenet/internal/config"
+)
+
+// Config keys, registered with the core config package so config.Load
+// resolves their defaults and runs their validators even though ledger owns
+// them.
+var (
+	backendTypeKey = config.NewKey("LEDGER_BACKEND_TYPE",
+		config.WithDefault(string(BackendInternal)),
+		config.WithValidator(func(v interface{}) error {
+			_, err := ParseBackendType(fmt.Sprint(v))
+			return err
+		}),
+	)
+	bufferSizeKey = config.NewKey("LEDGER_BUFFER_SIZE", config.WithDefault(256))
+	numWorkersKey = config.NewKey("LEDGER_NUM_WORKERS", config.WithDefault(4))
+	retryLimitKey = config.NewKey("LEDGER_RETRY_LIMIT", config.WithDefault(3))
+	retryWaitKey  = config.NewKey("LEDGER_RETRY_WAIT", config.WithDefault("2s"))
+
+	precomputedEventsFileKey = config.NewKey("LEDGER_PRECOMPUTED_EVENTS_FILE", config.WithDefault(""))
+)
+
+// BackendConfig controls how a LedgerBackend is constructed and how it
+// retries transient failures when talking to its rail.
+type BackendConfig struct {
+	Type       BackendType
+	BufferSize int
+	NumWorkers int
+	RetryLimit int
+	RetryWait  time.Duration
+
+	// PrecomputedEventsFile is the newline-delimited JSON event log
+	// BackendPrecomputed replays. Only meaningful when Type ==
+	// BackendPrecomputed.
+	PrecomputedEventsFile string
+}
+
+// LoadBackendConfig resolves BackendConfig from the process config.
+func LoadBackendConfig() (BackendConfig, error) {
+	backendType, err := ParseBackendType(backendTypeKey.GetString())
+	if err != nil {
+		return BackendConfig{}, err
+	}
+
+	retryWait, err := time.ParseDuration(retryWaitKey.GetString())
+	if err != nil {
+		return BackendConfig{}, fmt.Errorf("invalid LEDGER_RETRY_WAIT: %w", err)
+	}
+
+	return BackendConfig{
+		Type:                  backendType,
+		BufferSize:            bufferSizeKey.GetInt(),
+		NumWorkers:            numWorkersKey.GetInt(),
+		RetryLimit:            retryLimitKey.GetInt(),
+		RetryWait:             retryWait,
+		PrecomputedEventsFile: precomputedEventsFileKey.GetString(),
+	}, nil
+}
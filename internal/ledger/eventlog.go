@@ -0,0 +1,111 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// eventLog fans LedgerEvents out to every StreamEvents caller and keeps
+// enough history to replay a new subscriber in from an arbitrary cursor.
+// Embedded by backends (memoryBackend, precomputedBackend) so a second
+// caller to StreamEvents sees its own replay instead of stealing events from
+// the first.
+type eventLog struct {
+	mu          sync.Mutex
+	history     []LedgerEvent
+	subscribers map[chan LedgerEvent]struct{}
+	bufferSize  int
+	closed      bool
+}
+
+// newEventLog builds an eventLog, optionally seeded with events that already
+// happened (e.g. a precomputed fixture) so they're available for replay
+// without having gone through append.
+func newEventLog(bufferSize int, seed []LedgerEvent) *eventLog {
+	return &eventLog{
+		history:     append([]LedgerEvent(nil), seed...),
+		subscribers: make(map[chan LedgerEvent]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// append records event and delivers it to every current subscriber.
+// Delivery is best-effort: a subscriber too slow to keep up with its own
+// buffer misses live events rather than blocking the settlement path.
+func (l *eventLog) append(event LedgerEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.history = append(l.history, event)
+	for ch := range l.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// subscribe returns a channel that first replays every event recorded after
+// fromCursor (an empty cursor replays from the beginning), then receives new
+// events as append is called, until ctx is done.
+func (l *eventLog) subscribe(ctx context.Context, fromCursor string) (<-chan LedgerEvent, error) {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil, errors.New("ledger: event log is closed")
+	}
+
+	// The backlog is queued into the channel before it is registered, so no
+	// live event from append can overtake it.
+	backlog := replayFrom(l.history, fromCursor)
+	ch := make(chan LedgerEvent, len(backlog)+l.bufferSize)
+	for _, event := range backlog {
+		ch <- event
+	}
+	l.subscribers[ch] = struct{}{}
+	l.mu.Unlock()
+
+	go func() {
+		defer l.unsubscribe(ch)
+		<-ctx.Done()
+	}()
+
+	return ch, nil
+}
+
+func (l *eventLog) unsubscribe(ch chan LedgerEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.subscribers[ch]; ok {
+		delete(l.subscribers, ch)
+		close(ch)
+	}
+}
+
+// close shuts down every live subscriber channel. Afterwards subscribe
+// returns an error, and append still records to history but has no
+// subscribers to deliver to.
+func (l *eventLog) close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closed = true
+	for ch := range l.subscribers {
+		close(ch)
+	}
+	l.subscribers = nil
+}
+
+// replayFrom returns the events in history that come after the one with
+// cursor fromCursor, or every event if fromCursor is empty or unknown.
+func replayFrom(history []LedgerEvent, fromCursor string) []LedgerEvent {
+	if fromCursor == "" {
+		return append([]LedgerEvent(nil), history...)
+	}
+	for i, e := range history {
+		if e.Cursor == fromCursor {
+			return append([]LedgerEvent(nil), history[i+1:]...)
+		}
+	}
+	return append([]LedgerEvent(nil), history...)
+}
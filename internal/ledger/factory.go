@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/meqenet/meqenet/internal/config"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// NewBackend resolves the configured BackendType to a concrete
+// LedgerBackend. fx calls this (via Module) at boot to supply App's backend,
+// so the settlement rail in use is a config-time decision rather than a
+// compile-time one.
+func NewBackend(cfg BackendConfig) (LedgerBackend, error) {
+	switch cfg.Type {
+	case BackendInternal:
+		return newMemoryBackend(cfg), nil
+	case BackendPrecomputed:
+		return newPrecomputedBackend(cfg)
+	case BackendTelebirr, BackendCBE:
+		return newStubBackend(cfg.Type), nil
+	default:
+		return nil, fmt.Errorf("ledger: no backend registered for type %q", cfg.Type)
+	}
+}
+
+// registerLifecycle ties the resolved backend to the fx application,
+// closing it on shutdown.
+func registerLifecycle(lc fx.Lifecycle, backend LedgerBackend, cfg BackendConfig, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("ledger backend ready", zap.String("type", string(cfg.Type)))
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return backend.Close()
+		},
+	})
+}
+
+// Module provides the ledger package's constructors to the fx application graph.
+var Module = fx.Module("ledger",
+	// Depending on *config.Registry makes fx run config.Load first, so the
+	// ledger keys are read from the loaded config and their defaults.
+	fx.Provide(func(*config.Registry) (BackendConfig, error) { return LoadBackendConfig() }, NewBackend),
+	fx.Invoke(registerLifecycle),
+)
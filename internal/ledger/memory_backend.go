@@ -0,0 +1,79 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// memoryBackend is Meqenet's in-house ledger: an in-memory balance sheet
+// used for cashback and anything else that never touches an external rail.
+type memoryBackend struct {
+	mu       sync.Mutex
+	balances map[LoanID]decimal.Decimal
+	log      *eventLog
+	seq      uint64
+}
+
+func newMemoryBackend(cfg BackendConfig) *memoryBackend {
+	return &memoryBackend{
+		balances: make(map[LoanID]decimal.Decimal),
+		log:      newEventLog(cfg.BufferSize, nil),
+	}
+}
+
+func (b *memoryBackend) SettleInstallment(_ context.Context, loanID LoanID, amount decimal.Decimal) (TxRef, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.balances[loanID] = b.balances[loanID].Sub(amount)
+	return b.record(loanID, amount), nil
+}
+
+func (b *memoryBackend) RefundInstallment(_ context.Context, loanID LoanID, amount decimal.Decimal) (TxRef, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.balances[loanID] = b.balances[loanID].Add(amount)
+	return b.record(loanID, amount.Neg()), nil
+}
+
+func (b *memoryBackend) GetBalance(_ context.Context, loanID LoanID) (decimal.Decimal, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.balances[loanID], nil
+}
+
+// StreamEvents returns a channel private to this caller, replaying every
+// event recorded after fromCursor and then delivering new ones as they
+// happen, so two concurrent callers each see the full stream instead of
+// splitting it.
+func (b *memoryBackend) StreamEvents(ctx context.Context, fromCursor string) (<-chan LedgerEvent, error) {
+	return b.log.subscribe(ctx, fromCursor)
+}
+
+func (b *memoryBackend) Close() error {
+	b.log.close()
+	return nil
+}
+
+// record appends a settlement event and returns its synthetic TxRef. Caller
+// must hold b.mu.
+func (b *memoryBackend) record(loanID LoanID, amount decimal.Decimal) TxRef {
+	b.seq++
+	ref := TxRef(fmt.Sprintf("internal-%d", b.seq))
+
+	b.log.append(LedgerEvent{
+		Cursor:    fmt.Sprintf("%d", b.seq),
+		LoanID:    loanID,
+		TxRef:     ref,
+		Amount:    amount,
+		Timestamp: time.Now(),
+	})
+
+	return ref
+}
@@ -0,0 +1,70 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMemoryBackend_StreamEventsFansOutToEachCaller(t *testing.T) {
+	backend := newMemoryBackend(BackendConfig{BufferSize: 8})
+	defer backend.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first, err := backend.StreamEvents(ctx, "")
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+	second, err := backend.StreamEvents(ctx, "")
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+
+	if _, err := backend.SettleInstallment(ctx, LoanID("loan-1"), decimal.NewFromInt(100)); err != nil {
+		t.Fatalf("SettleInstallment: %v", err)
+	}
+
+	for name, ch := range map[string]<-chan LedgerEvent{"first": first, "second": second} {
+		select {
+		case event := <-ch:
+			if event.LoanID != "loan-1" {
+				t.Errorf("%s subscriber got event for %q, want loan-1", name, event.LoanID)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("%s subscriber never received the settlement event", name)
+		}
+	}
+}
+
+func TestMemoryBackend_StreamEventsReplaysFromCursor(t *testing.T) {
+	backend := newMemoryBackend(BackendConfig{BufferSize: 8})
+	defer backend.Close()
+
+	ctx := context.Background()
+	if _, err := backend.SettleInstallment(ctx, LoanID("loan-1"), decimal.NewFromInt(100)); err != nil {
+		t.Fatalf("SettleInstallment: %v", err)
+	}
+	if _, err := backend.SettleInstallment(ctx, LoanID("loan-1"), decimal.NewFromInt(50)); err != nil {
+		t.Fatalf("SettleInstallment: %v", err)
+	}
+
+	replayCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	replay, err := backend.StreamEvents(replayCtx, "1")
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+
+	select {
+	case event := <-replay:
+		if event.Cursor != "2" {
+			t.Errorf("replay from cursor %q got event cursor %q, want the one after it", "1", event.Cursor)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("replay never delivered the event after fromCursor")
+	}
+}
@@ -0,0 +1,52 @@
+package ledger
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPrecomputedBackend_ReplaysFixtureDeterministically(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "events.jsonl")
+	fixture := `{"Cursor":"1","LoanID":"loan-1","TxRef":"fixture-1","Amount":"100","Timestamp":"2025-01-01T00:00:00Z"}
+{"Cursor":"2","LoanID":"loan-1","TxRef":"fixture-2","Amount":"-25","Timestamp":"2025-01-02T00:00:00Z"}
+`
+	if err := os.WriteFile(path, []byte(fixture), 0o600); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+
+	backend, err := newPrecomputedBackend(BackendConfig{BufferSize: 8, PrecomputedEventsFile: path})
+	if err != nil {
+		t.Fatalf("newPrecomputedBackend: %v", err)
+	}
+	defer backend.Close()
+
+	ctx := context.Background()
+	balance, err := backend.GetBalance(ctx, LoanID("loan-1"))
+	if err != nil {
+		t.Fatalf("GetBalance: %v", err)
+	}
+	if want := decimal.NewFromInt(-75); !balance.Equal(want) {
+		t.Errorf("GetBalance() = %s, want %s", balance, want)
+	}
+
+	replay, err := backend.StreamEvents(ctx, "")
+	if err != nil {
+		t.Fatalf("StreamEvents: %v", err)
+	}
+	first := <-replay
+	second := <-replay
+	if first.Cursor != "1" || second.Cursor != "2" {
+		t.Errorf("replay returned cursors %q, %q, want fixture order 1, 2", first.Cursor, second.Cursor)
+	}
+}
+
+func TestNewPrecomputedBackend_RequiresEventsFile(t *testing.T) {
+	if _, err := newPrecomputedBackend(BackendConfig{BufferSize: 8}); err == nil {
+		t.Fatal("newPrecomputedBackend() with no PrecomputedEventsFile = nil error, want one")
+	}
+}
@@ -0,0 +1,42 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// stubBackend satisfies LedgerBackend for rails we don't have live
+// credentials for yet (Telebirr, CBE Birr). Every call fails with
+// ErrNotImplemented so misconfiguration is loud instead of silently
+// settling nowhere.
+//
+// TODO: replace with real Telebirr/CBE Birr rail clients once NBE
+// sandbox credentials are available.
+type stubBackend struct {
+	backendType BackendType
+}
+
+func newStubBackend(backendType BackendType) *stubBackend {
+	return &stubBackend{backendType: backendType}
+}
+
+func (b *stubBackend) SettleInstallment(context.Context, LoanID, decimal.Decimal) (TxRef, error) {
+	return "", ErrNotImplemented
+}
+
+func (b *stubBackend) RefundInstallment(context.Context, LoanID, decimal.Decimal) (TxRef, error) {
+	return "", ErrNotImplemented
+}
+
+func (b *stubBackend) GetBalance(context.Context, LoanID) (decimal.Decimal, error) {
+	return decimal.Decimal{}, ErrNotImplemented
+}
+
+func (b *stubBackend) StreamEvents(context.Context, string) (<-chan LedgerEvent, error) {
+	return nil, ErrNotImplemented
+}
+
+func (b *stubBackend) Close() error {
+	return nil
+}
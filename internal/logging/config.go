@@ -0,0 +1,19 @@
+package logging
+
+import "github.com/meqenet/meqenet/internal/config"
+
+// Log rotation keys, declared here rather than in the core config package
+// per the "downstream packages declare their own keys" convention.
+var (
+	logFileKey       = config.NewKey("LOG_FILE", config.WithDefault(""))
+	logMaxSizeMBKey  = config.NewKey("LOG_MAX_SIZE_MB", config.WithDefault(100))
+	logMaxBackupsKey = config.NewKey("LOG_MAX_BACKUPS", config.WithDefault(5))
+	logMaxAgeDaysKey = config.NewKey("LOG_MAX_AGE_DAYS", config.WithDefault(30))
+	logCompressKey   = config.NewKey("LOG_COMPRESS", config.WithDefault(true))
+)
+
+// trustedProxyCIDRsKey lists the CIDRs (comma-separated) of reverse proxies
+// allowed to set X-Forwarded-For/X-Real-Ip. HTTPMiddleware ignores both
+// headers from anyone else, since an untrusted peer can set them to
+// whatever it likes.
+var trustedProxyCIDRsKey = config.NewKey("TRUSTED_PROXY_CIDRS", config.WithDefault(""))
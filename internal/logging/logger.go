@@ -2,28 +2,55 @@
 package logging
 
 import (
+	"context"
 	"os"
 
+	"github.com/meqenet/meqenet/internal/config"
+	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
-// NewLogger creates a new structured logger
+// NewLogger creates a new structured logger. It always writes to stdout and,
+// when LOG_FILE is set, additionally to a rotating file sink. Every core is
+// wrapped so fields carrying PII are hashed before they ever reach a sink
+// (see redact.go) to meet NBE audit-trail requirements.
 func NewLogger() *zap.Logger {
-	config := zap.NewProductionConfig()
+	level := zapcore.InfoLevel
+	encoderCfg := zap.NewProductionEncoderConfig()
+	console := false
 
-	// Set log level based on environment
 	if os.Getenv("ENVIRONMENT") == "development" {
-		config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
-		config.Development = true
-		config.Encoding = "console"
-		config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		level = zapcore.DebugLevel
+		encoderCfg = zap.NewDevelopmentEncoderConfig()
+		encoderCfg.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		console = true
+	}
+
+	var encoder zapcore.Encoder
+	if console {
+		encoder = zapcore.NewConsoleEncoder(encoderCfg)
 	} else {
-		config.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
-		config.Encoding = "json"
+		encoder = zapcore.NewJSONEncoder(encoderCfg)
 	}
 
-	logger, err := config.Build(
+	cores := []zapcore.Core{zapcore.NewCore(encoder, zapcore.Lock(os.Stdout), level)}
+
+	if path := logFileKey.GetString(); path != "" {
+		rotator := &lumberjack.Logger{
+			Filename:   path,
+			MaxSize:    logMaxSizeMBKey.GetInt(),
+			MaxBackups: logMaxBackupsKey.GetInt(),
+			MaxAge:     logMaxAgeDaysKey.GetInt(),
+			Compress:   logCompressKey.GetBool(),
+		}
+		cores = append(cores, zapcore.NewCore(encoder, zapcore.AddSync(rotator), level))
+	}
+
+	core := redactPII(zapcore.NewTee(cores...), config.EncryptionKey.GetString())
+
+	return zap.New(core,
 		zap.AddCaller(),
 		zap.AddStacktrace(zapcore.ErrorLevel),
 		zap.Fields(
@@ -31,19 +58,14 @@ func NewLogger() *zap.Logger {
 			zap.String("version", "1.0.0"),
 		),
 	)
-	if err != nil {
-		panic("Failed to initialize logger: " + err.Error())
-	}
-
-	return logger
 }
 
 // NewTestLogger creates a logger suitable for testing
 func NewTestLogger() *zap.Logger {
-	config := zap.NewDevelopmentConfig()
-	config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+	cfg := zap.NewDevelopmentConfig()
+	cfg.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
 
-	logger, err := config.Build(
+	logger, err := cfg.Build(
 		zap.AddCaller(),
 		zap.WithCaller(true),
 	)
@@ -53,3 +75,21 @@ func NewTestLogger() *zap.Logger {
 
 	return logger
 }
+
+// registerHooks ties the logger's lifetime to the fx application, flushing
+// buffered log entries when the application stops.
+func registerHooks(lc fx.Lifecycle, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return logger.Sync()
+		},
+	})
+}
+
+// Module provides the logging package's constructors to the fx application graph.
+var Module = fx.Module("logging",
+	// Depending on *config.Registry makes fx run config.Load first, so
+	// LOG_FILE and ENCRYPTION_KEY are read from the loaded config.
+	fx.Provide(func(*config.Registry) *zap.Logger { return NewLogger() }),
+	fx.Invoke(registerHooks),
+)
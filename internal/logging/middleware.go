@@ -0,0 +1,138 @@
+package logging
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type contextKey string
+
+// userIDContextKey is set by auth middleware once it lands, upstream of
+// HTTPMiddleware, so request logs can carry the authenticated user.
+const userIDContextKey contextKey = "meqenet-user-id"
+
+// WithUserID attaches an authenticated user ID to ctx so HTTPMiddleware can
+// include it on the request's log entry.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDContextKey, userID)
+}
+
+// HTTPMiddleware returns net/http middleware that emits one structured log
+// entry per request, once the handler has finished serving it.
+func HTTPMiddleware(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", rec.status),
+				zap.Float64("latency_ms", float64(time.Since(start))/float64(time.Millisecond)),
+				zap.String("client_ip", clientIP(r)),
+				zap.String("request_id", r.Header.Get("X-Request-Id")),
+			}
+
+			if merchantID := r.Header.Get("X-Merchant-Id"); merchantID != "" {
+				fields = append(fields, zap.String("merchant_id", merchantID))
+			}
+			if userID, ok := r.Context().Value(userIDContextKey).(string); ok && userID != "" {
+				fields = append(fields, zap.String("user_id", userID))
+			}
+
+			logger.Info("http request", fields...)
+		})
+	}
+}
+
+// statusRecorder captures the status code a handler wrote so it can be
+// logged after the fact; http.ResponseWriter doesn't expose it directly.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// clientIP returns the peer address, honoring X-Forwarded-For/X-Real-Ip only
+// when the immediate peer is a configured trusted proxy — otherwise those
+// headers are attacker-controlled and would let any client spoof the
+// client_ip on every audit-log entry. When the peer is trusted, it walks
+// X-Forwarded-For from the last hop backwards and returns the first one
+// that isn't itself a trusted proxy, per the standard XFF-parsing algorithm.
+func clientIP(r *http.Request) string {
+	peerIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peerIP = r.RemoteAddr
+	}
+
+	trusted := trustedProxyNets()
+	if !isTrustedProxy(peerIP, trusted) {
+		return peerIP
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if hop == "" {
+				continue
+			}
+			if !isTrustedProxy(hop, trusted) {
+				return hop
+			}
+		}
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-Ip")); realIP != "" {
+		return realIP
+	}
+
+	return peerIP
+}
+
+// trustedProxyNets parses TRUSTED_PROXY_CIDRS, skipping any entry that
+// doesn't parse as a CIDR.
+func trustedProxyNets() []*net.IPNet {
+	raw := trustedProxyCIDRsKey.GetString()
+	if raw == "" {
+		return nil
+	}
+
+	var nets []*net.IPNet
+	for _, cidr := range strings.Split(raw, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			nets = append(nets, ipNet)
+		}
+	}
+	return nets
+}
+
+// isTrustedProxy reports whether ip falls within any of the trusted CIDRs.
+func isTrustedProxy(ip string, trusted []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range trusted {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package logging
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestClientIP_UntrustedPeerCannotSpoofForwardedFor(t *testing.T) {
+	viper.Set("TRUSTED_PROXY_CIDRS", "10.0.0.0/8")
+	defer viper.Set("TRUSTED_PROXY_CIDRS", "")
+
+	req := &http.Request{
+		RemoteAddr: "203.0.113.7:54321", // not in the trusted CIDR
+		Header:     http.Header{"X-Forwarded-For": []string{"6.6.6.6"}},
+	}
+
+	if got := clientIP(req); got != "203.0.113.7" {
+		t.Errorf("clientIP() = %q, want the untrusted peer address, not the spoofed header", got)
+	}
+}
+
+func TestClientIP_TrustedProxyForwardsLastUntrustedHop(t *testing.T) {
+	viper.Set("TRUSTED_PROXY_CIDRS", "10.0.0.0/8")
+	defer viper.Set("TRUSTED_PROXY_CIDRS", "")
+
+	req := &http.Request{
+		RemoteAddr: "10.0.0.5:54321", // inside the trusted CIDR
+		Header:     http.Header{"X-Forwarded-For": []string{"203.0.113.7, 10.0.0.9"}},
+	}
+
+	if got := clientIP(req); got != "203.0.113.7" {
+		t.Errorf("clientIP() = %q, want the last untrusted hop", got)
+	}
+}
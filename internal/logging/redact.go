@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// piiPrefix marks a field key as carrying PII; SensitiveField adds it and
+// piiCore strips it again after hashing the value.
+const piiPrefix = "pii:"
+
+// wellKnownPIIFields are redacted even if a call site forgot to use
+// SensitiveField, since these specific names are known NBE-sensitive
+// attributes.
+var wellKnownPIIFields = map[string]bool{
+	"fayda_id": true,
+	"phone":    true,
+	"pan":      true,
+	"email":    true,
+	"dob":      true,
+}
+
+// SensitiveField marks a string field as PII so the logging core hashes its
+// value instead of writing it in the clear. Apart from the names in
+// wellKnownPIIFields, a field is redacted only when its call site opts in
+// through SensitiveField.
+func SensitiveField(name, value string) zap.Field {
+	return zap.String(piiPrefix+name, value)
+}
+
+// piiCore wraps another core and hashes any field flagged as PII (either by
+// name, via wellKnownPIIFields, or by prefix, via SensitiveField) with an
+// HMAC keyed on a per-tenant salt, so raw PII never reaches a sink.
+type piiCore struct {
+	zapcore.Core
+	salt string
+}
+
+// redactPII wraps core so every Write first passes fields through redaction.
+func redactPII(core zapcore.Core, salt string) zapcore.Core {
+	return &piiCore{Core: core, salt: salt}
+}
+
+func (c *piiCore) With(fields []zapcore.Field) zapcore.Core {
+	return &piiCore{Core: c.Core.With(redactFields(fields, c.salt)), salt: c.salt}
+}
+
+func (c *piiCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+func (c *piiCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, redactFields(fields, c.salt))
+}
+
+func redactFields(fields []zapcore.Field, salt string) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		key := f.Key
+		sensitive := wellKnownPIIFields[key]
+
+		if strings.HasPrefix(key, piiPrefix) {
+			key = strings.TrimPrefix(key, piiPrefix)
+			sensitive = true
+		}
+
+		if !sensitive {
+			redacted[i] = f
+			continue
+		}
+
+		redacted[i] = zap.String(key, hashPII(key, f.String, salt))
+	}
+	return redacted
+}
+
+// hashPII derives a stable, non-reversible digest for a PII value, salted
+// per tenant so the same value hashes differently across deployments.
+func hashPII(key, value, salt string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(key))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,43 @@
+// Package payments owns the Meqenet platform's connections to payment
+// processors beyond the settlement rails ledger already models (card
+// networks, mobile money top-ups). It is not wired to a real processor yet;
+// Module exists so its startup/shutdown ordering is driven by fx like every
+// other subsystem instead of a TODO in app.startServices.
+package payments
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Client is a placeholder for a payment processor connection. New will
+// return a real client once a processor is chosen; until then it carries no
+// connection.
+type Client struct{}
+
+// New returns a Client. It does not connect to a processor yet — see
+// Client.
+func New() (*Client, error) {
+	return &Client{}, nil
+}
+
+// registerLifecycle ties Client's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *Client, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("payments: no processor wired yet, skipping connect")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the payments package's constructors to the fx application graph.
+var Module = fx.Module("payments",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
@@ -0,0 +1,42 @@
+// Package queue owns the Meqenet platform's connection to its message
+// queue, used for asynchronous work such as statement generation and
+// settlement retries. It is not wired to a real broker yet; Module exists
+// so startup/shutdown ordering is driven by fx like every other subsystem
+// instead of a TODO in app.startServices.
+package queue
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Client is a placeholder for the message queue connection. New will return
+// a real client once a broker is chosen; until then it carries no
+// connection.
+type Client struct{}
+
+// New returns a Client. It does not connect to a broker yet — see Client.
+func New() (*Client, error) {
+	return &Client{}, nil
+}
+
+// registerLifecycle ties Client's lifetime to the fx application.
+func registerLifecycle(lc fx.Lifecycle, _ *Client, logger *zap.Logger) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("queue: no broker wired yet, skipping connect")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return nil
+		},
+	})
+}
+
+// Module provides the queue package's constructors to the fx application graph.
+var Module = fx.Module("queue",
+	fx.Provide(New),
+	fx.Invoke(registerLifecycle),
+)
@@ -0,0 +1,18 @@
+// Meqenet.et - Enterprise BNPL Platform
+// Main application entry point
+// NBE Compliant Ethiopian Financial Services Platform
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/meqenet/meqenet/internal/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}